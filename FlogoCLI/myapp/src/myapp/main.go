@@ -35,6 +35,11 @@ func main() {
     }
 
     flag.Parse()
+    if flag.Arg(0) == "validate" {
+        // only validate the app configuration
+        os.Exit(validate(app))
+    }
+
     if *cpuprofile != "" {
         f, err := os.Create(*cpuprofile)
         if err != nil {
@@ -57,7 +62,7 @@ func main() {
 		os.Exit(1)
 	}
 
-	exitChan := setupSignalHandling()
+	exitChan := setupSignalHandling(e)
 
 	code := <-exitChan
 
@@ -81,7 +86,19 @@ func main() {
 	os.Exit(code)
 }
 
-func setupSignalHandling() chan int {
+// validate reports the problems found in the app configuration, the exit code is 1 if there are any
+func validate(appCfg *app.Config) int {
+
+	if err := engine.Validate(appCfg); err != nil {
+		fmt.Println(err.Error())
+		return 1
+	}
+
+	fmt.Printf("App [ %s ] is valid\n", appCfg.Name)
+	return 0
+}
+
+func setupSignalHandling(e engine.Engine) chan int {
 
 	signalChan := make(chan os.Signal, 1)
 	signal.Notify(signalChan,
@@ -91,16 +108,23 @@ func setupSignalHandling() chan int {
 		syscall.SIGQUIT)
 
 	exitChan := make(chan int, 1)
-	select {
-	case s := <-signalChan:
+	for {
+		s := <-signalChan
 		switch s {
-		case syscall.SIGHUP, syscall.SIGINT, syscall.SIGTERM, syscall.SIGQUIT:
+		case syscall.SIGHUP:
+			// reload the app configuration instead of exiting
+			log.Info("Received SIGHUP, reloading app configuration")
+			if err := engine.ReloadAppConfig(e); err != nil {
+				log.Errorf("Failed to reload app configuration due to error: %s", err.Error())
+			}
+		case syscall.SIGINT, syscall.SIGTERM, syscall.SIGQUIT:
 			exitChan <- 0
+			return exitChan
 		default:
 			logger.Debug("Unknown signal.")
 			exitChan <- 1
+			return exitChan
 		}
 	}
-	return exitChan
 }
 